@@ -1,92 +1,657 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/ericchiang/k8s"
 	corev1 "github.com/ericchiang/k8s/apis/core/v1"
 	"github.com/ghodss/yaml"
+	"github.com/godbus/dbus/v5"
 	"github.com/namsral/flag"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
+	"hash/fnv"
 	"io/ioutil"
+	"net"
+	"net/http"
 	"os"
 	"os/exec"
-	"reflect"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
 	"text/template"
 	"time"
 )
 
+const (
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAFile    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+
+	// debounceDelay coalesces bursts of watch events into a single reconcile.
+	debounceDelay = 500 * time.Millisecond
+
+	// watchRetryMaxBackoff caps the exponential backoff applied when
+	// re-establishing a watch after it is closed or errors out.
+	watchRetryMaxBackoff = 30 * time.Second
+
+	// listTimeout bounds a single cluster's List call so that one
+	// unresponsive tenant cluster can't stall reconciliation of the
+	// others when fullResync fans out across all of them.
+	listTimeout = 15 * time.Second
+)
+
 type Config struct {
-	kubeConfig   string
-	tmplFile     string
-	configFile   string
-	reloadScript string
-	syncPeriod   int
-	debug        bool
+	kubeConfig       string
+	tmplFile         string
+	configFile       string
+	reloadScript     string
+	reloadMode       string
+	role             string
+	pidFile          string
+	stateFile        string
+	syncPeriod       int
+	labelSelector    string
+	annotationPrefix string
+	listen           string
+	debug            bool
+}
+
+// serviceEvent carries a single ADDED/MODIFIED/DELETED change observed on
+// the service watch, keyed the same way services are stored in the
+// reconciler's in-memory set.
+type serviceEvent struct {
+	eventType string
+	key       string
+	service   Service
+}
+
+// Port is a service port exposed through the rendered keepalived
+// virtual_server entries, with its native Kubernetes protocol.
+type Port struct {
+	Port     int32
+	Protocol string
 }
 
 type Service struct {
+	Cluster   string
 	Name      string
 	Namespace string
 	IP        string
+	Ports     []Port
+
+	// VRRP metadata, derived from annotations under config.annotationPrefix.
+	VRID           int
+	Priority       int
+	Group          string
+	AdvertInterval int
+	Peers          []string
+	NotifyScript   string
+}
+
+// VRRPMeta holds the per-service VRRP metadata parsed from annotations.
+type VRRPMeta struct {
+	VRID           int
+	Priority       int
+	Group          string
+	AdvertInterval int
+	Peers          []string
+	NotifyScript   string
+}
+
+// vrrpDefaultPriority and vrrpDefaultAdvertInterval are applied when a
+// service carries no explicit priority/advert-interval annotation.
+const (
+	vrrpDefaultPriority       = 100
+	vrrpDefaultAdvertInterval = 1
+)
+
+// parseVRRPMeta reads the VRID, priority, virtual_router group, advert
+// interval, unicast peer list and notify script for a service from its
+// annotations, honouring config.annotationPrefix (e.g. "vrrp.k8s.io/"). A
+// service without a vrid annotation gets VRID 0, meaning "no dedicated
+// vrrp_instance", and is rendered IP-only.
+func parseVRRPMeta(annotations map[string]string, prefix string) (VRRPMeta, error) {
+
+	meta := VRRPMeta{Priority: vrrpDefaultPriority, AdvertInterval: vrrpDefaultAdvertInterval}
+
+	if raw, ok := annotations[prefix+"vrid"]; ok && raw != "" {
+		vrid, err := strconv.Atoi(raw)
+		if err != nil {
+			return meta, fmt.Errorf("invalid %v annotation %q: %v", prefix+"vrid", raw, err)
+		}
+		if vrid < 1 || vrid > 255 {
+			return meta, fmt.Errorf("%v annotation %d out of range 1-255", prefix+"vrid", vrid)
+		}
+		meta.VRID = vrid
+	}
+
+	if raw, ok := annotations[prefix+"priority"]; ok && raw != "" {
+		priority, err := strconv.Atoi(raw)
+		if err != nil {
+			return meta, fmt.Errorf("invalid %v annotation %q: %v", prefix+"priority", raw, err)
+		}
+		meta.Priority = priority
+	}
+
+	meta.Group = annotations[prefix+"group"]
+
+	if raw, ok := annotations[prefix+"advert-interval"]; ok && raw != "" {
+		interval, err := strconv.Atoi(raw)
+		if err != nil {
+			return meta, fmt.Errorf("invalid %v annotation %q: %v", prefix+"advert-interval", raw, err)
+		}
+		meta.AdvertInterval = interval
+	}
+
+	if raw, ok := annotations[prefix+"peers"]; ok && raw != "" {
+		for _, peer := range strings.Split(raw, ",") {
+			meta.Peers = append(meta.Peers, strings.TrimSpace(peer))
+		}
+	}
+
+	meta.NotifyScript = annotations[prefix+"notify"]
+
+	return meta, nil
 }
 
 var config Config
 var log = logrus.New()
 
-func loadClient(kubeconfigPath string) (*k8s.Client, error) {
+// healthState backs the /healthz and /readyz endpoints: healthy once the
+// initial service list has succeeded, ready while the most recent reconcile
+// both succeeded and happened within the freshness window.
+type healthState struct {
+	mu                   sync.Mutex
+	initialListDone      bool
+	lastReconcileAt      time.Time
+	lastReconcileOK      bool
+	lastSuccessfulReload time.Time
+}
+
+func (h *healthState) markInitialListDone() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.initialListDone = true
+}
+
+func (h *healthState) markReconcile(ok bool, at time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.lastReconcileOK = ok
+	h.lastReconcileAt = at
+}
+
+func (h *healthState) markSuccessfulReload(at time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.lastSuccessfulReload = at
+}
 
-	data, err := ioutil.ReadFile(kubeconfigPath)
+func (h *healthState) healthy() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.initialListDone
+}
+
+func (h *healthState) ready(window time.Duration) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.lastReconcileOK && time.Since(h.lastReconcileAt) < window
+}
+
+func (h *healthState) secondsSinceLastSuccessfulReload() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.lastSuccessfulReload.IsZero() {
+		return -1
+	}
+	return time.Since(h.lastSuccessfulReload).Seconds()
+}
+
+var health = &healthState{}
+
+var (
+	metricServicesRendered = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "vrrp_services_rendered",
+		Help: "Number of services currently rendered into the config file.",
+	})
+	metricReconcileSuccessTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "vrrp_reconcile_success_total",
+		Help: "Total number of reconciles that rendered and reloaded successfully.",
+	})
+	metricReconcileFailureTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "vrrp_reconcile_failure_total",
+		Help: "Total number of reconciles that failed to render or reload.",
+	})
+	metricReloadExitCode = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "vrrp_reload_script_exit_code",
+		Help: "Exit code of the last reload script invocation.",
+	})
+	metricRenderDurationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "vrrp_render_duration_seconds",
+		Help: "Time spent rendering the config template.",
+	})
+	metricLastReloadTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "vrrp_last_successful_reload_timestamp_seconds",
+		Help: "Unix timestamp of the last successful reload.",
+	})
+	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "vrrp_seconds_since_last_successful_reload",
+		Help: "Seconds elapsed since the last successful reload, -1 if there has been none yet.",
+	}, health.secondsSinceLastSuccessfulReload)
+)
+
+// startHealthServer serves /healthz, /readyz and /metrics in the background.
+// /healthz is 200 once the initial service list has succeeded; /readyz is
+// 200 only while the last reconcile succeeded within 2*syncPeriod.
+func startHealthServer(addr string, readyWindow time.Duration) {
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if !health.healthy() {
+			http.Error(w, "initial service list not yet completed", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if !health.ready(readyWindow) {
+			http.Error(w, "no successful reconcile within the freshness window", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		log.Infof("Listening on %v", addr)
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Errorf("Health/metrics server stopped: %v", err)
+		}
+	}()
+}
+
+// clusterClient pairs a k8s.Client with the name of the kubeconfig context it
+// was built from, used to tag every Service it returns and as the cache key
+// when loading several clusters at once.
+type clusterClient struct {
+	name   string
+	client *k8s.Client
+}
+
+// kubeConfigPaths expands the -kubeConfig flag into the individual
+// kubeconfig files to load: a comma-separated list, every regular file in a
+// directory, or a single path.
+func kubeConfigPaths(raw string) ([]string, error) {
+
+	info, err := os.Stat(raw)
+	if err == nil && info.IsDir() {
+		entries, err := ioutil.ReadDir(raw)
+		if err != nil {
+			return nil, fmt.Errorf("read kubeConfig directory %v: %v", raw, err)
+		}
+
+		var paths []string
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			paths = append(paths, filepath.Join(raw, entry.Name()))
+		}
+		sort.Strings(paths)
+
+		return paths, nil
+	}
+
+	var paths []string
+	for _, path := range strings.Split(raw, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths, nil
+}
+
+// loadClientFromFile builds a k8s.Client from a single kubeconfig file,
+// returning its CurrentContext (falling back to the file path) as the
+// cluster name, along with the server URL the context points at so callers
+// can tell genuinely distinct clusters apart even if they happen to share a
+// context name.
+func loadClientFromFile(path string) (name string, server string, client *k8s.Client, err error) {
+
+	data, err := ioutil.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("read kubeconfig: %v", err)
+		return "", "", nil, fmt.Errorf("read kubeconfig %v: %v", path, err)
 	}
 
 	var cfg k8s.Config
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("unmarshal kubeconfig: %v", err)
+		return "", "", nil, fmt.Errorf("unmarshal kubeconfig %v: %v", path, err)
+	}
+
+	name = cfg.CurrentContext
+	if name == "" {
+		name = path
+	}
+
+	server = clusterServer(cfg)
+
+	client, err = k8s.NewClient(&cfg)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("build client for context %v: %v", name, err)
 	}
 
-	return k8s.NewClient(&cfg)
+	return name, server, client, nil
 }
 
-func getServices(client *k8s.Client) (services []Service, err error) {
+// clusterServer resolves the API server URL that cfg.CurrentContext points
+// at, returning "" if it cannot be resolved (e.g. a malformed kubeconfig).
+func clusterServer(cfg k8s.Config) string {
+
+	var clusterName string
+	for _, c := range cfg.Contexts {
+		if c.Name == cfg.CurrentContext {
+			clusterName = c.Context.Cluster
+			break
+		}
+	}
+
+	for _, c := range cfg.Clusters {
+		if c.Name == clusterName {
+			return c.Cluster.Server
+		}
+	}
+
+	return ""
+}
+
+// loadClients expands -kubeConfig and builds one k8s.Client per context.
+// Clusters are deduplicated by server URL, not context name, since context
+// names like "default" collide constantly across unrelated kubeconfigs: a
+// name collision between two different server URLs is a configuration
+// mistake and fails loudly rather than silently dropping one tenant
+// cluster's entire VIP set.
+func loadClients(kubeConfig string) ([]clusterClient, error) {
+
+	paths, err := kubeConfigPaths(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	seenServers := make(map[string]string, len(paths))
+	seenNames := make(map[string]string, len(paths))
+	var clients []clusterClient
+
+	for _, path := range paths {
+		name, server, client, err := loadClientFromFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if server != "" {
+			if prev, ok := seenServers[server]; ok {
+				log.Warnf("Cluster %v (from %v) is the same server as %v, skipping", name, path, prev)
+				continue
+			}
+			seenServers[server] = name
+		}
+
+		if prev, ok := seenNames[name]; ok && prev != server {
+			return nil, fmt.Errorf("cluster name %v (from %v) collides with a different cluster using the same name; rename one context to disambiguate", name, path)
+		}
+		seenNames[name] = server
+
+		clients = append(clients, clusterClient{name: name, client: client})
+	}
+
+	return clients, nil
+}
+
+// runningInPod reports whether the process looks like it is running inside a
+// Kubernetes Pod, i.e. the Kubernetes service env vars are set and the
+// service account token is mounted.
+func runningInPod() bool {
+
+	if os.Getenv("KUBERNETES_SERVICE_HOST") == "" || os.Getenv("KUBERNETES_SERVICE_PORT") == "" {
+		return false
+	}
+
+	_, err := os.Stat(serviceAccountTokenFile)
+	return err == nil
+}
+
+// loadInClusterClient builds a k8s.Client from the service account token, CA
+// bundle and API server env vars mounted by Kubernetes into every Pod, the
+// same pattern as client-go's restclient.InClusterConfig, so the controller
+// can run as a Pod without shipping a kubeconfig around.
+func loadInClusterClient() (*k8s.Client, error) {
+
+	host := os.Getenv("KUBERNETES_SERVICE_HOST")
+	port := os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT must be set")
+	}
+
+	token, err := ioutil.ReadFile(serviceAccountTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("read service account token: %v", err)
+	}
+
+	ca, err := ioutil.ReadFile(serviceAccountCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read service account ca: %v", err)
+	}
+
+	cfg := &k8s.Config{
+		CurrentContext: "in-cluster",
+		Clusters: []k8s.NamedCluster{
+			{
+				Name: "in-cluster",
+				Cluster: k8s.Cluster{
+					Server:                   "https://" + net.JoinHostPort(host, port),
+					CertificateAuthorityData: ca,
+				},
+			},
+		},
+		AuthInfos: []k8s.NamedAuthInfo{
+			{
+				Name: "in-cluster",
+				AuthInfo: k8s.AuthInfo{
+					Token: string(token),
+				},
+			},
+		},
+		Contexts: []k8s.NamedContext{
+			{
+				Name: "in-cluster",
+				Context: k8s.Context{
+					Cluster:  "in-cluster",
+					AuthInfo: "in-cluster",
+				},
+			},
+		},
+	}
+
+	return k8s.NewClient(cfg)
+}
+
+// serviceKey returns the map key under which a service is tracked by the
+// reconciler, cluster/namespace/name being unique across all loaded
+// clusters.
+func serviceKey(cluster, namespace, name string) string {
+	return cluster + "/" + namespace + "/" + name
+}
+
+// servicePorts converts a Service's native port list, keeping each port's
+// Kubernetes protocol (TCP/UDP) for the rendered virtual_server entries.
+func servicePorts(s *corev1.Service) []Port {
+
+	ports := make([]Port, 0, len(s.Spec.Ports))
+	for _, p := range s.Spec.Ports {
+		protocol := "TCP"
+		if p.Protocol != nil {
+			protocol = *p.Protocol
+		}
+		ports = append(ports, Port{Port: *p.Port, Protocol: protocol})
+	}
+
+	return ports
+}
+
+// toService converts a candidate core/v1 Service into our Service, returning
+// ok=false when the service is not a LoadBalancer with an IP assigned and
+// should be dropped from the rendered set, or an error when it carries
+// malformed VRRP annotations.
+func toService(s *corev1.Service, cluster string) (svc Service, ok bool, err error) {
+
+	log.Debugf("Service Candidate : %v:%v:%+v type=%+v", cluster, *s.Metadata.Namespace, *s.Metadata.Name, *s.Spec.Type)
+
+	if *s.Spec.Type != "LoadBalancer" {
+		log.Debugf(" - Dropped candidate : %+v, not loadbalancer type", *s.Metadata.Name)
+		return Service{}, false, nil
+	}
+
+	ip := s.Spec.GetLoadBalancerIP()
+	if ip == "" {
+		log.Debugf(" - Dropped candidate : %+v, no loadbalancer IP", *s.Metadata.Name)
+		return Service{}, false, nil
+	}
+
+	meta, err := parseVRRPMeta(s.Metadata.Annotations, config.annotationPrefix)
+	if err != nil {
+		return Service{}, false, fmt.Errorf("%v/%v/%v: %v", cluster, *s.Metadata.Namespace, *s.Metadata.Name, err)
+	}
+
+	svc = Service{
+		Cluster:        cluster,
+		Name:           *s.Metadata.Name,
+		Namespace:      *s.Metadata.Namespace,
+		IP:             ip,
+		Ports:          servicePorts(s),
+		VRID:           meta.VRID,
+		Priority:       meta.Priority,
+		Group:          meta.Group,
+		AdvertInterval: meta.AdvertInterval,
+		Peers:          meta.Peers,
+		NotifyScript:   meta.NotifyScript,
+	}
+
+	log.Debugf("Candidate OK : %+v", svc)
+
+	return svc, true, nil
+}
+
+func getServices(client *k8s.Client, cluster string) (services []Service, err error) {
 
 	var svcs corev1.ServiceList
-	err = client.List(context.Background(), k8s.AllNamespaces, &svcs)
+	var opts []k8s.Option
+	if config.labelSelector != "" {
+		opts = append(opts, k8s.QueryParam("labelSelector", config.labelSelector))
+	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+
+	err = client.List(ctx, k8s.AllNamespaces, &svcs, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("Cannot list services: %v", err)
 	}
 
 	for _, s := range svcs.Items {
-
-		log.Debugf("Service Candidate : %v:%+v type=%+v", *s.Metadata.Namespace, *s.Metadata.Name, *s.Spec.Type)
-
-		if *s.Spec.Type != "LoadBalancer" {
-			log.Debugf(" - Dropped candidate : %+v, not loadbalancer type", *s.Metadata.Name)
+		svc, ok, err := toService(s, cluster)
+		if err != nil {
+			log.Errorf("Dropping candidate, invalid VRRP annotation: %v", err)
 			continue
 		}
+		if ok {
+			services = append(services, svc)
+		}
+	}
 
-		if *s.Spec.LoadBalancerIP == "" {
-			log.Debugf(" - Dropped candidate : %+v, no loadbalancer IP", *s.Metadata.Name)
+	return services, nil
+}
+
+// getAllServices lists candidate services on every cluster concurrently and
+// merges the results.
+func getAllServices(clients []clusterClient) ([]Service, error) {
+
+	type result struct {
+		cluster  string
+		services []Service
+		err      error
+	}
+
+	results := make(chan result, len(clients))
+	for _, cc := range clients {
+		go func(cc clusterClient) {
+			services, err := getServices(cc.client, cc.name)
+			results <- result{cluster: cc.name, services: services, err: err}
+		}(cc)
+	}
+
+	var all []Service
+	var errs []string
+	for range clients {
+		r := <-results
+		if r.err != nil {
+			errs = append(errs, fmt.Sprintf("%v: %v", r.cluster, r.err))
 			continue
 		}
+		all = append(all, r.services...)
+	}
 
-		cService := Service{
-			Name:      *s.Metadata.Name,
-			Namespace: *s.Metadata.Namespace,
-			IP:        *s.Spec.LoadBalancerIP,
+	if len(errs) > 0 {
+		return all, fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+
+	return all, nil
+}
+
+// validateServices checks cross-service VRRP invariants that cannot be
+// caught while parsing a single service's annotations: VRIDs must be unique
+// across the whole rendered set, and so must the advertised IPs, since two
+// clusters racing to advertise the same VIP would be a misconfiguration.
+func validateServices(services []Service) error {
+
+	vridSeenBy := make(map[int]string)
+	// ipSeenBy tracks the cluster that first advertised an IP, so that two
+	// services sharing an IP within the same cluster (the standard MetalLB
+	// allow-shared-ip pattern, fronting different ports of one VIP) aren't
+	// flagged -- only a collision across clusters is a misconfiguration.
+	ipSeenBy := make(map[string]Service)
+
+	for _, s := range services {
+		key := serviceKey(s.Cluster, s.Namespace, s.Name)
+
+		if other, dup := ipSeenBy[s.IP]; dup && other.Cluster != s.Cluster {
+			return fmt.Errorf("IP %v is advertised by both %v (cluster %v) and %v (cluster %v)", s.IP, serviceKey(other.Cluster, other.Namespace, other.Name), other.Cluster, key, s.Cluster)
 		}
+		ipSeenBy[s.IP] = s
 
-		services = append(services, cService)
+		if s.VRID == 0 {
+			continue
+		}
 
-		log.Debugf("Candidate OK : %+v", cService)
+		if other, dup := vridSeenBy[s.VRID]; dup {
+			return fmt.Errorf("vrid %d is used by both %v and %v", s.VRID, other, key)
+		}
+		vridSeenBy[s.VRID] = key
 	}
 
-	return services, nil
+	return nil
 }
 
-func configureServices(services []Service, tmplFile string, configFile string) {
+func configureServices(services []Service, tmplFile string, configFile string) error {
 
 	for n, service := range services {
 		log.Infof("-+= Service #%v", n)
@@ -95,54 +660,431 @@ func configureServices(services []Service, tmplFile string, configFile string) {
 		log.Infof(" `--== IP : %v", service.IP)
 	}
 
-	t, err := template.ParseFiles(tmplFile)
-	if err != nil {
-		log.Errorf("Failed to load template file: %v", err)
-		return
+	if err := validateServices(services); err != nil {
+		return fmt.Errorf("invalid VRRP configuration: %v", err)
 	}
 
-	w, err := os.Create(configFile)
+	t, err := template.ParseFiles(tmplFile)
 	if err != nil {
-		log.Errorf("Failed to open config file: %v", err)
-		return
+		return fmt.Errorf("Failed to load template file: %v", err)
 	}
 
 	conf := make(map[string]interface{})
 	conf["services"] = services
 
-	err = t.Execute(w, conf)
+	var rendered bytes.Buffer
+	renderStart := time.Now()
+	err = t.Execute(&rendered, conf)
+	metricRenderDurationSeconds.Observe(time.Since(renderStart).Seconds())
 	if err != nil {
-		log.Errorf("Failed to write config file: %v", err)
-		return
-	} else {
-		log.Infof("Write config file: %v", configFile)
+		return fmt.Errorf("Failed to render config template: %v", err)
+	}
+	metricServicesRendered.Set(float64(len(services)))
+
+	if existing, err := ioutil.ReadFile(configFile); err == nil && bytes.Equal(existing, rendered.Bytes()) {
+		log.Infof("Config unchanged, skipping reload")
+		return nil
+	}
+
+	if err := writeConfigFileAtomic(configFile, rendered.Bytes()); err != nil {
+		return fmt.Errorf("Failed to write config file: %v", err)
+	}
+	log.Infof("Write config file: %v", configFile)
+
+	if role := currentRole(); role == "master" {
+		offset := masterReloadOffset()
+		log.Infof("On MASTER, deferring reload by %v to let peers reload first", offset)
+		time.Sleep(offset)
 	}
 
 	log.Infof("Ready to reload proxy")
 
+	if err := doReload(); err != nil {
+		return fmt.Errorf("reload failed: %v", err)
+	}
+
+	metricLastReloadTimestamp.Set(float64(time.Now().Unix()))
+	health.markSuccessfulReload(time.Now())
+
+	return nil
+}
+
+// writeConfigFileAtomic writes data to path without ever exposing a
+// partially-written file to keepalived: it writes to path+".tmp", fsyncs,
+// then renames over path.
+func writeConfigFileAtomic(path string, data []byte) error {
+
+	tmp := path + ".tmp"
+
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// doReload dispatches to the reload backend selected by -reloadMode.
+func doReload() error {
+	switch config.reloadMode {
+	case "signal":
+		return reloadSignal()
+	case "dbus":
+		return reloadDBus()
+	default:
+		return reloadScript()
+	}
+}
+
+// reloadScript is the original reload backend: shell out to -reloadScript.
+func reloadScript() error {
+
 	out, err := exec.Command(config.reloadScript).CombinedOutput()
+
+	exitCode := 0
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		exitCode = exitErr.ExitCode()
+	} else if err != nil {
+		exitCode = -1
+	}
+	metricReloadExitCode.Set(float64(exitCode))
+
 	if err != nil {
 		log.Errorf("Error reloading proxy: %v\n%s", err, out)
-	} else {
-		log.Infof("Reload script succeed:\n%s", out)
+		return err
+	}
+
+	log.Infof("Reload script succeed:\n%s", out)
+	return nil
+}
+
+// reloadSignal sends SIGHUP to the keepalived process tracked by -pidFile.
+func reloadSignal() error {
+
+	data, err := ioutil.ReadFile(config.pidFile)
+	if err != nil {
+		metricReloadExitCode.Set(-1)
+		return fmt.Errorf("read pidfile %v: %v", config.pidFile, err)
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		metricReloadExitCode.Set(-1)
+		return fmt.Errorf("parse pidfile %v: %v", config.pidFile, err)
+	}
+
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		metricReloadExitCode.Set(-1)
+		return fmt.Errorf("find process %d: %v", pid, err)
+	}
+
+	if err := proc.Signal(syscall.SIGHUP); err != nil {
+		metricReloadExitCode.Set(-1)
+		return fmt.Errorf("signal pid %d: %v", pid, err)
+	}
+
+	log.Infof("Sent SIGHUP to keepalived pid %d", pid)
+	metricReloadExitCode.Set(0)
+
+	return nil
+}
+
+const (
+	dbusKeepalivedService      = "org.keepalived.Vrrp1"
+	dbusKeepalivedObjectPath   = "/org/keepalived/Vrrp1"
+	dbusKeepalivedReloadMethod = "org.keepalived.Vrrp1.Vrrp.Reload"
+)
+
+// reloadDBus asks the running keepalived to reload over its DBus interface,
+// which reloads the config without dropping VRRP state the way restarting
+// the process would.
+func reloadDBus() error {
+
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		metricReloadExitCode.Set(-1)
+		return fmt.Errorf("connect system dbus: %v", err)
+	}
+	defer conn.Close()
+
+	obj := conn.Object(dbusKeepalivedService, dbus.ObjectPath(dbusKeepalivedObjectPath))
+	call := obj.Call(dbusKeepalivedReloadMethod, 0)
+	if call.Err != nil {
+		metricReloadExitCode.Set(-1)
+		return fmt.Errorf("dbus reload: %v", call.Err)
+	}
+
+	log.Infof("Reloaded keepalived via DBus")
+	metricReloadExitCode.Set(0)
+
+	return nil
+}
+
+// masterReloadJitterMax bounds the delay a MASTER node sleeps before
+// reloading, derived deterministically from its own hostname so routers
+// don't need to coordinate to stagger their reloads.
+const masterReloadJitterMax = 3 * time.Second
+
+// currentRole resolves -role: "master"/"backup" are returned as-is, "auto"
+// inspects -stateFile, which is expected to be kept up to date by
+// keepalived's notify_master/notify_backup scripts.
+func currentRole() string {
+	switch config.role {
+	case "master", "backup":
+		return config.role
+	default:
+		return detectRoleFromStateFile(config.stateFile)
+	}
+}
+
+func detectRoleFromStateFile(path string) string {
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Debugf("Cannot read VRRP state file %v: %v, assuming backup", path, err)
+		return "backup"
+	}
+
+	if strings.Contains(strings.ToUpper(string(data)), "MASTER") {
+		return "master"
+	}
+
+	return "backup"
+}
+
+// masterReloadOffset derives a reload delay from the local hostname, so that
+// among a set of peers only the MASTER ever pauses before reloading, and
+// different MASTERs across clusters don't all reload in lockstep.
+func masterReloadOffset() time.Duration {
+
+	hostname, _ := os.Hostname()
+
+	h := fnv.New32a()
+	h.Write([]byte(hostname))
+
+	return time.Duration(h.Sum32()%uint32(masterReloadJitterMax/time.Millisecond)) * time.Millisecond
+}
+
+// watchCluster streams ADDED/MODIFIED/DELETED service events from a single
+// cluster onto the shared events channel, re-establishing the watch with
+// exponential backoff whenever it errors out, until ctx is cancelled.
+func watchCluster(ctx context.Context, cc clusterClient, events chan<- serviceEvent) {
+
+	backoff := time.Second
+
+	for ctx.Err() == nil {
+		err := watchClusterOnce(ctx, cc, events, &backoff)
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Errorf("Service watch for cluster %v ended (%v), reconnecting in %v", cc.name, err, backoff)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > watchRetryMaxBackoff {
+			backoff = watchRetryMaxBackoff
+		}
+	}
+}
+
+// watchClusterOnce runs a single watch attempt until it errors out, resetting
+// backoff to its floor on every event successfully received.
+func watchClusterOnce(ctx context.Context, cc clusterClient, events chan<- serviceEvent, backoff *time.Duration) error {
+
+	var opts []k8s.Option
+	if config.labelSelector != "" {
+		opts = append(opts, k8s.QueryParam("labelSelector", config.labelSelector))
+	}
+
+	watcher, err := cc.client.Watch(ctx, k8s.AllNamespaces, new(corev1.Service), opts...)
+	if err != nil {
+		return fmt.Errorf("start watch: %v", err)
+	}
+	defer watcher.Close()
+
+	for {
+		s := new(corev1.Service)
+		eventType, err := watcher.Next(s)
+		if err != nil {
+			return fmt.Errorf("watch ended: %v", err)
+		}
+
+		*backoff = time.Second
+
+		key := serviceKey(cc.name, *s.Metadata.Namespace, *s.Metadata.Name)
+
+		if eventType == k8s.EventDeleted {
+			events <- serviceEvent{eventType: eventType, key: key}
+			continue
+		}
+
+		svc, ok, err := toService(s, cc.name)
+		if err != nil {
+			log.Errorf("Dropping candidate, invalid VRRP annotation: %v", err)
+			// No longer renderable: treat like a deletion of our rendered entry.
+			events <- serviceEvent{eventType: k8s.EventDeleted, key: key}
+			continue
+		}
+		if !ok {
+			// No longer a candidate: treat like a deletion of our rendered entry.
+			events <- serviceEvent{eventType: k8s.EventDeleted, key: key}
+			continue
+		}
+
+		events <- serviceEvent{eventType: eventType, key: key, service: svc}
+	}
+}
+
+// sortedServices returns the values of a cluster/namespace/name keyed service
+// set in a stable order, so repeated renders of an unchanged set produce
+// identical config file bytes.
+func sortedServices(services map[string]Service) []Service {
+
+	list := make([]Service, 0, len(services))
+	for _, s := range services {
+		list = append(list, s)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return serviceKey(list[i].Cluster, list[i].Namespace, list[i].Name) <
+			serviceKey(list[j].Cluster, list[j].Namespace, list[j].Name)
+	})
+
+	return list
+}
+
+// reconcile watches services across every loaded cluster and re-renders the
+// config whenever the merged set of candidate LoadBalancer services changes,
+// instead of re-listing and diffing every syncPeriod. Bursts of watch events
+// are coalesced through a debounce timer before a render is triggered. A
+// periodic full resync is kept as a safety net to recover from missed events
+// or watch resets, each cluster's watch re-establishing itself with
+// exponential backoff whenever it ends.
+func reconcile(ctx context.Context, clients []clusterClient, resyncPeriod time.Duration) {
+
+	services := make(map[string]Service)
+
+	fullResync := func() bool {
+		newServices, err := getAllServices(clients)
+		if err != nil {
+			log.Errorf("Failed full resync: %v", err)
+			return false
+		}
+		services = make(map[string]Service, len(newServices))
+		for _, s := range newServices {
+			services[serviceKey(s.Cluster, s.Namespace, s.Name)] = s
+		}
+		return true
 	}
 
-	return
+	render := func() {
+		err := configureServices(sortedServices(services), config.tmplFile, config.configFile)
+		health.markReconcile(err == nil, time.Now())
+		if err != nil {
+			metricReconcileFailureTotal.Inc()
+			log.Errorf("Reconcile failed: %v", err)
+			return
+		}
+		metricReconcileSuccessTotal.Inc()
+	}
+
+	log.Infof("Initial GetServices fired")
+	if !fullResync() {
+		log.Fatalf("Failed initial GetServices")
+	}
+	health.markInitialListDone()
+	render()
+
+	resyncTicker := time.NewTicker(resyncPeriod)
+	defer resyncTicker.Stop()
+
+	debounce := time.NewTimer(debounceDelay)
+	if !debounce.Stop() {
+		<-debounce.C
+	}
+
+	events := make(chan serviceEvent)
+	for _, cc := range clients {
+		go watchCluster(ctx, cc, events)
+	}
+
+	for {
+		select {
+		case ev := <-events:
+			if ev.eventType == k8s.EventDeleted {
+				delete(services, ev.key)
+			} else {
+				services[ev.key] = ev.service
+			}
+
+			debounce.Reset(debounceDelay)
+
+		case <-debounce.C:
+			log.Infof("Services have changed, reload fired")
+			render()
+
+		case <-resyncTicker.C:
+			log.Infof("Periodic full resync fired")
+			if fullResync() {
+				render()
+			}
+		}
+	}
 }
 
 func init() {
 
-	flag.StringVar(&config.kubeConfig, "kubeConfig", os.Getenv("HOME")+"/.kube/config", "kubeconfig file to load")
+	flag.StringVar(&config.kubeConfig, "kubeConfig", "", "kubeconfig file(s) to load: a comma-separated list, a directory, or a single path (empty: use in-cluster config when running as a Pod, otherwise "+os.Getenv("HOME")+"/.kube/config)")
 	flag.StringVar(&config.tmplFile, "tmplFile", "config.tmpl", "Template file to load")
 	flag.StringVar(&config.configFile, "configFile", "config.conf", "Configuration file to write")
 	flag.StringVar(&config.reloadScript, "reloadScript", "./reload.sh", "Reload script to launch")
-	flag.IntVar(&config.syncPeriod, "syncPeriod", 10, "Period between update")
+	flag.StringVar(&config.reloadMode, "reloadMode", "script", "How to reload the VRRP proxy: script (run -reloadScript), signal (SIGHUP -pidFile), or dbus (keepalived DBus Reload)")
+	flag.StringVar(&config.role, "role", "auto", "VRRP role of this node: auto (detect from -stateFile), master, or backup. The master defers its reload so peers reload first")
+	flag.StringVar(&config.pidFile, "pidFile", "/var/run/keepalived.pid", "keepalived pidfile, used when -reloadMode=signal")
+	flag.StringVar(&config.stateFile, "stateFile", "/var/run/keepalived.state", "keepalived VRRP state file, used when -role=auto")
+	flag.IntVar(&config.syncPeriod, "syncPeriod", 300, "Full resync period (seconds), a safety net on top of the service watch")
+	flag.StringVar(&config.labelSelector, "labelSelector", "", "Label selector to restrict watched LoadBalancer services (e.g. \"app=foo\")")
+	flag.StringVar(&config.annotationPrefix, "annotationPrefix", "vrrp.k8s.io/", "Annotation prefix used to read per-service VRRP metadata (vrid, priority, group, advert-interval, peers, notify)")
+	flag.StringVar(&config.listen, "listen", ":8080", "Address to serve /healthz, /readyz and /metrics on")
 	flag.BoolVar(&config.debug, "debug", false, "Enable debug messages")
 
 	log.Formatter = new(logrus.TextFormatter)
 	log.Level = logrus.InfoLevel
 }
 
+// validateFlags rejects unrecognized -reloadMode/-role values up front
+// instead of letting them silently fall back to their defaults, since a
+// typo there would otherwise quietly defeat the coordination/no-VRRP-drop
+// guarantees those flags exist to provide.
+func validateFlags() {
+
+	switch config.reloadMode {
+	case "script", "signal", "dbus":
+	default:
+		log.Fatalf("Invalid -reloadMode %q: must be one of script, signal, dbus", config.reloadMode)
+	}
+
+	switch config.role {
+	case "auto", "master", "backup":
+	default:
+		log.Fatalf("Invalid -role %q: must be one of auto, master, backup", config.role)
+	}
+}
+
 func main() {
 
 	flag.Parse()
@@ -150,30 +1092,35 @@ func main() {
 		log.SetLevel(logrus.DebugLevel)
 	}
 
-	client, err := loadClient(config.kubeConfig)
-	if err != nil {
-		log.Fatalf("Failed to create client: %v", err)
-	}
+	validateFlags()
 
-	log.Infof("Initial GetServices fired")
-	currentServices, err := getServices(client)
+	var clients []clusterClient
+	var err error
+	switch {
+	case config.kubeConfig == "" && runningInPod():
+		log.Infof("Loading in-cluster client")
+		var client *k8s.Client
+		client, err = loadInClusterClient()
+		if err == nil {
+			clients = []clusterClient{{name: "in-cluster", client: client}}
+		}
+	default:
+		if config.kubeConfig == "" {
+			config.kubeConfig = os.Getenv("HOME") + "/.kube/config"
+		}
+		log.Infof("Loading client(s) from kubeConfig: %v", config.kubeConfig)
+		clients, err = loadClients(config.kubeConfig)
+	}
 	if err != nil {
-		log.Fatalf("Failed initial GetServices: %v", err)
+		log.Fatalf("Failed to create client(s): %v", err)
 	}
-	configureServices(currentServices, config.tmplFile, config.configFile)
-
-	for t := range time.NewTicker(time.Duration(config.syncPeriod) * time.Second).C {
+	if len(clients) == 0 {
+		log.Fatalf("No usable kubeConfig found in %v", config.kubeConfig)
+	}
+	log.Infof("Loaded %v cluster client(s)", len(clients))
 
-		log.Debugf("GetServices fired at %+v", t)
-		newServices, err := getServices(client)
-		if err != nil {
-			log.Errorf("Failed GetServices: %v", err)
-		}
+	syncPeriod := time.Duration(config.syncPeriod) * time.Second
+	startHealthServer(config.listen, 2*syncPeriod)
 
-		if !reflect.DeepEqual(newServices, currentServices) {
-			log.Infof("Services have changed, reload fired")
-			currentServices = newServices
-			configureServices(currentServices, config.tmplFile, config.configFile)
-		}
-	}
+	reconcile(context.Background(), clients, syncPeriod)
 }
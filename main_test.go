@@ -0,0 +1,156 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestParseVRRPMeta(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     bool
+		want        VRRPMeta
+	}{
+		{
+			name:        "defaults when no annotations set",
+			annotations: map[string]string{},
+			want:        VRRPMeta{Priority: vrrpDefaultPriority, AdvertInterval: vrrpDefaultAdvertInterval},
+		},
+		{
+			name: "valid vrid in range",
+			annotations: map[string]string{
+				"vrrp.k8s.io/vrid": "42",
+			},
+			want: VRRPMeta{VRID: 42, Priority: vrrpDefaultPriority, AdvertInterval: vrrpDefaultAdvertInterval},
+		},
+		{
+			name: "vrid below range",
+			annotations: map[string]string{
+				"vrrp.k8s.io/vrid": "0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "vrid above range",
+			annotations: map[string]string{
+				"vrrp.k8s.io/vrid": "256",
+			},
+			wantErr: true,
+		},
+		{
+			name: "vrid not a number",
+			annotations: map[string]string{
+				"vrrp.k8s.io/vrid": "nope",
+			},
+			wantErr: true,
+		},
+		{
+			name: "priority not a number",
+			annotations: map[string]string{
+				"vrrp.k8s.io/priority": "nope",
+			},
+			wantErr: true,
+		},
+		{
+			name: "advert-interval not a number",
+			annotations: map[string]string{
+				"vrrp.k8s.io/advert-interval": "nope",
+			},
+			wantErr: true,
+		},
+		{
+			name: "peers split and trimmed",
+			annotations: map[string]string{
+				"vrrp.k8s.io/peers": "10.0.0.1, 10.0.0.2,10.0.0.3",
+			},
+			want: VRRPMeta{
+				Priority:       vrrpDefaultPriority,
+				AdvertInterval: vrrpDefaultAdvertInterval,
+				Peers:          []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseVRRPMeta(tt.annotations, "vrrp.k8s.io/")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseVRRPMeta() = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseVRRPMeta() unexpected error: %v", err)
+			}
+			if got.VRID != tt.want.VRID || got.Priority != tt.want.Priority || got.Group != tt.want.Group ||
+				got.AdvertInterval != tt.want.AdvertInterval || got.NotifyScript != tt.want.NotifyScript ||
+				len(got.Peers) != len(tt.want.Peers) {
+				t.Fatalf("parseVRRPMeta() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got.Peers {
+				if got.Peers[i] != tt.want.Peers[i] {
+					t.Fatalf("parseVRRPMeta() peer[%d] = %v, want %v", i, got.Peers[i], tt.want.Peers[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateServices(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []Service
+		wantErr  bool
+	}{
+		{
+			name: "unique IPs and VRIDs",
+			services: []Service{
+				{Cluster: "a", Namespace: "ns", Name: "svc1", IP: "10.0.0.1", VRID: 1},
+				{Cluster: "a", Namespace: "ns", Name: "svc2", IP: "10.0.0.2", VRID: 2},
+			},
+		},
+		{
+			name: "VRID 0 means unset and is exempt from uniqueness",
+			services: []Service{
+				{Cluster: "a", Namespace: "ns", Name: "svc1", IP: "10.0.0.1", VRID: 0},
+				{Cluster: "a", Namespace: "ns", Name: "svc2", IP: "10.0.0.2", VRID: 0},
+			},
+		},
+		{
+			name: "duplicate IP across clusters",
+			services: []Service{
+				{Cluster: "a", Namespace: "ns", Name: "svc1", IP: "10.0.0.1", VRID: 1},
+				{Cluster: "b", Namespace: "ns", Name: "svc1", IP: "10.0.0.1", VRID: 2},
+			},
+			wantErr: true,
+		},
+		{
+			name: "shared IP within the same cluster is allowed (MetalLB allow-shared-ip)",
+			services: []Service{
+				{Cluster: "a", Namespace: "ns", Name: "svc1", IP: "10.0.0.1", VRID: 1},
+				{Cluster: "a", Namespace: "ns", Name: "svc2", IP: "10.0.0.1", VRID: 2},
+			},
+		},
+		{
+			name: "duplicate VRID across services",
+			services: []Service{
+				{Cluster: "a", Namespace: "ns", Name: "svc1", IP: "10.0.0.1", VRID: 1},
+				{Cluster: "a", Namespace: "ns", Name: "svc2", IP: "10.0.0.2", VRID: 1},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateServices(tt.services)
+			if tt.wantErr && err == nil {
+				t.Fatalf("validateServices() = nil, want error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("validateServices() unexpected error: %v", err)
+			}
+		})
+	}
+}